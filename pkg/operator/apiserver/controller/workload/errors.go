@@ -0,0 +1,12 @@
+package workload
+
+import "errors"
+
+// ErrWorkloadNotFound may be returned by a Delegate.Sync implementation,
+// together with a nil Workload, to indicate that the underlying resource is
+// genuinely absent from the cluster rather than merely unreadable because of
+// a transient API error or a short-circuited sync. updateOperatorStatus uses
+// its presence in the returned errors to set a ResourceNotFound reason
+// instead of SyncFailed, and to leave Progressing/Available reflecting that
+// absence rather than the last known good state.
+var ErrWorkloadNotFound = errors.New("workload not found")
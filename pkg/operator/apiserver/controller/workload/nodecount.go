@@ -0,0 +1,73 @@
+package workload
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// nodeCountReplicas holds the configuration installed by
+// WithNodeCountReplicas: the desired replica count tracks the number of
+// Nodes matching nodeSelector, clamped to [min, max].
+type nodeCountReplicas struct {
+	nodeLister   corev1listers.NodeLister
+	nodeSelector map[string]string
+	min, max     int32
+}
+
+func (n *nodeCountReplicas) desiredReplicas() (int32, error) {
+	nodes, err := n.nodeLister.List(labels.SelectorFromSet(n.nodeSelector))
+	if err != nil {
+		return 0, err
+	}
+	return clampInt32(int32(len(nodes)), n.min, n.max), nil
+}
+
+func clampInt32(v, min, max int32) int32 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+// WithNodeCountReplicas makes the Controller track nodeInformer and compute
+// a desired replica count from the number of Nodes matching nodeSelector,
+// clamped to [min, max]. This encodes the "replicas == number of matching
+// nodes" pattern (e.g. one replica per master node) so operators don't have
+// to reimplement the Node-watch and resync plumbing on top of
+// CountNodesFuncWrapper themselves: the Node informer is added alongside the
+// caller's own informers, so an add, delete or label change on a Node
+// triggers a resync same as any other watched resource.
+//
+// Call SetNodeCountReplicas from your Delegate.Sync, while building the
+// workload spec you are about to apply, to read the computed value back.
+func WithNodeCountReplicas(nodeInformer cache.SharedIndexInformer, nodeLister corev1listers.NodeLister, nodeSelector map[string]string, min, max int32) ControllerOption {
+	return func(c *Controller) {
+		c.nodeCountReplicas = &nodeCountReplicas{
+			nodeLister:   nodeLister,
+			nodeSelector: nodeSelector,
+			min:          min,
+			max:          max,
+		}
+		c.extraInformers = append(c.extraInformers, nodeInformer)
+	}
+}
+
+// SetNodeCountReplicas mutates spec.Replicas to the number of Nodes matching
+// the selector configured via WithNodeCountReplicas, clamped to [min, max].
+// It is a no-op when WithNodeCountReplicas wasn't used.
+func (c *Controller) SetNodeCountReplicas(spec *appsv1.DeploymentSpec) error {
+	if c.nodeCountReplicas == nil {
+		return nil
+	}
+	replicas, err := c.nodeCountReplicas.desiredReplicas()
+	if err != nil {
+		return err
+	}
+	spec.Replicas = &replicas
+	return nil
+}
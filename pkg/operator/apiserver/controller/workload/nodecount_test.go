@@ -0,0 +1,78 @@
+package workload
+
+import (
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestClampInt32(t *testing.T) {
+	tests := []struct {
+		v, min, max, want int32
+	}{
+		{v: 2, min: 1, max: 5, want: 2},
+		{v: 0, min: 1, max: 5, want: 1},
+		{v: 9, min: 1, max: 5, want: 5},
+		{v: 3, min: 3, max: 3, want: 3},
+	}
+	for _, tc := range tests {
+		if got := clampInt32(tc.v, tc.min, tc.max); got != tc.want {
+			t.Errorf("clampInt32(%d, %d, %d) = %d, want %d", tc.v, tc.min, tc.max, got, tc.want)
+		}
+	}
+}
+
+func newNodeLister(t *testing.T, labels ...map[string]string) corev1listers.NodeLister {
+	t.Helper()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for i, l := range labels {
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("node-%d", i), Labels: l}}
+		if err := indexer.Add(node); err != nil {
+			t.Fatalf("failed to seed node lister: %v", err)
+		}
+	}
+	return corev1listers.NewNodeLister(indexer)
+}
+
+func TestNodeCountReplicasDesiredReplicasCountsMatchingNodes(t *testing.T) {
+	masterLabel := map[string]string{"node-role.kubernetes.io/master": ""}
+	workerLabel := map[string]string{"node-role.kubernetes.io/worker": ""}
+
+	n := &nodeCountReplicas{
+		nodeLister:   newNodeLister(t, masterLabel, masterLabel, workerLabel),
+		nodeSelector: masterLabel,
+		min:          1,
+		max:          5,
+	}
+
+	got, err := n.desiredReplicas()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 2 {
+		t.Errorf("expected 2 matching master nodes, got %d", got)
+	}
+}
+
+func TestNodeCountReplicasDesiredReplicasClampsToMin(t *testing.T) {
+	masterLabel := map[string]string{"node-role.kubernetes.io/master": ""}
+
+	n := &nodeCountReplicas{
+		nodeLister:   newNodeLister(t),
+		nodeSelector: masterLabel,
+		min:          3,
+		max:          5,
+	}
+
+	got, err := n.desiredReplicas()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 3 {
+		t.Errorf("expected the min (3) when no nodes match, got %d", got)
+	}
+}
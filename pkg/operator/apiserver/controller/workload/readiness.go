@@ -0,0 +1,196 @@
+package workload
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+)
+
+// DegradedReason is a single, structured reason why a workload should be
+// reported as Degraded, along with a human-readable message describing it.
+type DegradedReason struct {
+	// Reason is a CamelCase reason suitable for an OperatorCondition, for
+	// example "CrashLoopBackOff" or "ProgressDeadlineExceeded".
+	Reason string
+	// Message describes the reason in more detail.
+	Message string
+}
+
+// ReadinessChecker inspects a workload and the pods it owns to produce
+// degraded reasons beyond what AvailableReplicas, UpdatedReplicas and
+// ObservedGeneration alone can tell. An empty result means the checker found
+// nothing wrong.
+type ReadinessChecker interface {
+	CheckReadiness(ctx context.Context, workload Workload, podsLister corev1listers.PodLister, kubeClient kubernetes.Interface) []DegradedReason
+}
+
+// helmStyleReadinessChecker is the default ReadinessChecker, modeled on Helm
+// 3's kube.ReadyChecker: it inspects the workload's own status conditions as
+// well as the container statuses of the pods it owns.
+type helmStyleReadinessChecker struct{}
+
+// NewHelmStyleReadinessChecker returns the default ReadinessChecker used by
+// Controller when none is configured via WithReadinessChecker.
+func NewHelmStyleReadinessChecker() ReadinessChecker {
+	return helmStyleReadinessChecker{}
+}
+
+func (helmStyleReadinessChecker) CheckReadiness(ctx context.Context, workload Workload, podsLister corev1listers.PodLister, kubeClient kubernetes.Interface) []DegradedReason {
+	var reasons []DegradedReason
+
+	switch w := workload.(type) {
+	case deploymentWorkload:
+		reasons = append(reasons, deploymentConditionReasons(w.Deployment)...)
+	case statefulSetWorkload:
+		reasons = append(reasons, statefulSetPVCReasons(ctx, w, kubeClient)...)
+	}
+
+	reasons = append(reasons, podContainerReasons(workload, podsLister)...)
+
+	return reasons
+}
+
+// deploymentConditionReasons reports a Deployment whose Progressing condition
+// has reason ProgressDeadlineExceeded, and one whose ReplicaFailure condition
+// is true. A Progressing condition with any other reason (for example
+// ReplicaSetUpdated during a rollout) is not reported; the reason is not
+// compared against NewReplicaSetAvailable.
+func deploymentConditionReasons(deployment *appsv1.Deployment) []DegradedReason {
+	var reasons []DegradedReason
+	for _, cond := range deployment.Status.Conditions {
+		switch {
+		case cond.Type == appsv1.DeploymentProgressing && cond.Reason == "ProgressDeadlineExceeded":
+			reasons = append(reasons, DegradedReason{
+				Reason:  "ProgressDeadlineExceeded",
+				Message: fmt.Sprintf("deployment/%s timed out (DeploymentTimedOut): %s", deployment.Name, cond.Message),
+			})
+		case cond.Type == appsv1.DeploymentReplicaFailure && cond.Status == corev1.ConditionTrue:
+			reasons = append(reasons, DegradedReason{
+				Reason:  "ReplicaFailure",
+				Message: fmt.Sprintf("deployment/%s has a replica failure: %s", deployment.Name, cond.Message),
+			})
+		}
+	}
+	return reasons
+}
+
+// podContainerReasons walks the pods selected by workload and reports
+// containers that are crash-looping, failing to pull their image, or
+// otherwise unable to start.
+func podContainerReasons(workload Workload, podsLister corev1listers.PodLister) []DegradedReason {
+	selector := workload.Selector()
+	if selector == nil || podsLister == nil {
+		return nil
+	}
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil
+	}
+	pods, err := podsLister.Pods(workload.GetNamespace()).List(labelSelector)
+	if err != nil {
+		return nil
+	}
+
+	var reasons []DegradedReason
+	for _, pod := range pods {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			reason, ok := containerDegradedReason(pod.Name, containerStatus)
+			if ok {
+				reasons = append(reasons, reason)
+			}
+		}
+	}
+	return reasons
+}
+
+// minRestartCountForNotReady is the RestartCount a not-ready container must
+// reach before containerDegradedReason reports it as ContainerNotReady.
+// CrashLoopBackOff already catches the fast-crash-loop case; this only needs
+// to catch containers that are restarting repeatedly without ever tripping
+// that waiting reason.
+const minRestartCountForNotReady = 3
+
+func containerDegradedReason(podName string, containerStatus corev1.ContainerStatus) (DegradedReason, bool) {
+	if waiting := containerStatus.State.Waiting; waiting != nil {
+		switch waiting.Reason {
+		case "CrashLoopBackOff", "ImagePullBackOff", "CreateContainerConfigError":
+			return DegradedReason{
+				Reason: waiting.Reason,
+				Message: fmt.Sprintf("pod/%s container %q (%s) is in %s: %s",
+					podName, containerStatus.Name, containerStatus.Image, waiting.Reason, waiting.Message),
+			}, true
+		}
+	}
+
+	// A container that keeps restarting while not ready, with no more
+	// specific waiting reason, still indicates trouble worth surfacing. We
+	// have no per-sync state to compare RestartCount against its previous
+	// value, so instead of flagging on a single restart (which would also
+	// fire for a pod that recovered from a one-off crash weeks ago and is
+	// later bounced for an unrelated, benign reason), require enough
+	// restarts to look like an ongoing problem that CrashLoopBackOff hasn't
+	// already caught.
+	if !containerStatus.Ready && containerStatus.RestartCount >= minRestartCountForNotReady && containerStatus.LastTerminationState.Terminated != nil {
+		terminated := containerStatus.LastTerminationState.Terminated
+		return DegradedReason{
+			Reason: "ContainerNotReady",
+			Message: fmt.Sprintf("pod/%s container %q (%s) last terminated with exit code %d (%s) and has restarted %d times",
+				podName, containerStatus.Name, containerStatus.Image, terminated.ExitCode, terminated.Reason, containerStatus.RestartCount),
+		}, true
+	}
+
+	return DegradedReason{}, false
+}
+
+// statefulSetPVCReasons checks that every PVC materialized from the
+// StatefulSet's volumeClaimTemplates, for each currently desired ordinal, is
+// Bound.
+func statefulSetPVCReasons(ctx context.Context, workload statefulSetWorkload, kubeClient kubernetes.Interface) []DegradedReason {
+	if kubeClient == nil || len(workload.Spec.VolumeClaimTemplates) == 0 {
+		return nil
+	}
+
+	var reasons []DegradedReason
+	for _, template := range workload.Spec.VolumeClaimTemplates {
+		for ordinal := int32(0); ordinal < workload.DesiredReplicas(); ordinal++ {
+			pvcName := fmt.Sprintf("%s-%s-%d", template.Name, workload.GetName(), ordinal)
+			pvc, err := kubeClient.CoreV1().PersistentVolumeClaims(workload.GetNamespace()).Get(ctx, pvcName, metav1.GetOptions{})
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					// Not provisioned yet; give the StatefulSet controller a
+					// chance to create it rather than calling this degraded.
+					continue
+				}
+				reasons = append(reasons, DegradedReason{
+					Reason:  "PersistentVolumeClaimNotBound",
+					Message: fmt.Sprintf("persistentvolumeclaim/%s could not be retrieved: %v", pvcName, err),
+				})
+				continue
+			}
+			if pvc.Status.Phase != corev1.ClaimBound {
+				reasons = append(reasons, DegradedReason{
+					Reason:  "PersistentVolumeClaimNotBound",
+					Message: fmt.Sprintf("persistentvolumeclaim/%s is %s, not Bound", pvcName, pvc.Status.Phase),
+				})
+			}
+		}
+	}
+	return reasons
+}
+
+// degradedReasonsMessage joins the messages of reasons into a single string
+// suitable for an OperatorCondition's Message field.
+func degradedReasonsMessage(reasons []DegradedReason) string {
+	messages := make([]string, 0, len(reasons))
+	for _, reason := range reasons {
+		messages = append(messages, reason.Message)
+	}
+	return strings.Join(messages, "; ")
+}
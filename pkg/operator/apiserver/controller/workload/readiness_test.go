@@ -0,0 +1,218 @@
+package workload
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestContainerDegradedReasonWaiting(t *testing.T) {
+	status := corev1.ContainerStatus{
+		Name:  "main",
+		Image: "quay.io/example/image:latest",
+		State: corev1.ContainerState{
+			Waiting: &corev1.ContainerStateWaiting{
+				Reason:  "ImagePullBackOff",
+				Message: "rpc error: image not found",
+			},
+		},
+	}
+
+	reason, ok := containerDegradedReason("pod-1", status)
+	if !ok {
+		t.Fatalf("expected a degraded reason for a container waiting on ImagePullBackOff")
+	}
+	if reason.Reason != "ImagePullBackOff" {
+		t.Errorf("expected reason ImagePullBackOff, got %q", reason.Reason)
+	}
+}
+
+func TestContainerDegradedReasonRestartCountThreshold(t *testing.T) {
+	terminated := &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}
+
+	tests := []struct {
+		name         string
+		restartCount int32
+		ready        bool
+		wantDegraded bool
+	}{
+		{
+			name:         "never restarted",
+			restartCount: 0,
+			wantDegraded: false,
+		},
+		{
+			name:         "single past restart, e.g. a node drain or rolling restart",
+			restartCount: 1,
+			wantDegraded: false,
+		},
+		{
+			name:         "ready despite restarts",
+			restartCount: 5,
+			ready:        true,
+			wantDegraded: false,
+		},
+		{
+			name:         "restarts at the threshold and not ready",
+			restartCount: minRestartCountForNotReady,
+			wantDegraded: true,
+		},
+		{
+			name:         "restarts well past the threshold and not ready",
+			restartCount: minRestartCountForNotReady + 5,
+			wantDegraded: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			status := corev1.ContainerStatus{
+				Name:         "main",
+				Ready:        tc.ready,
+				RestartCount: tc.restartCount,
+				LastTerminationState: corev1.ContainerState{
+					Terminated: terminated,
+				},
+			}
+
+			_, ok := containerDegradedReason("pod-1", status)
+			if ok != tc.wantDegraded {
+				t.Errorf("restartCount=%d ready=%v: got degraded=%v, want %v", tc.restartCount, tc.ready, ok, tc.wantDegraded)
+			}
+		})
+	}
+}
+
+func TestDeploymentConditionReasons(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		Status: appsv1.DeploymentStatus{
+			Conditions: []appsv1.DeploymentCondition{
+				{
+					Type:    appsv1.DeploymentProgressing,
+					Reason:  "ProgressDeadlineExceeded",
+					Message: "deployment exceeded its progress deadline",
+				},
+				{
+					Type:    appsv1.DeploymentReplicaFailure,
+					Status:  corev1.ConditionTrue,
+					Message: "pods cannot be created",
+				},
+			},
+		},
+	}
+	deployment.Name = "my-deployment"
+
+	reasons := deploymentConditionReasons(deployment)
+	if len(reasons) != 2 {
+		t.Fatalf("expected 2 reasons, got %d: %+v", len(reasons), reasons)
+	}
+	if reasons[0].Reason != "ProgressDeadlineExceeded" {
+		t.Errorf("expected first reason ProgressDeadlineExceeded, got %q", reasons[0].Reason)
+	}
+	if reasons[1].Reason != "ReplicaFailure" {
+		t.Errorf("expected second reason ReplicaFailure, got %q", reasons[1].Reason)
+	}
+}
+
+func TestDeploymentConditionReasonsAsExpected(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		Status: appsv1.DeploymentStatus{
+			Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentProgressing, Reason: "NewReplicaSetAvailable"},
+				{Type: appsv1.DeploymentReplicaFailure, Status: corev1.ConditionFalse},
+			},
+		},
+	}
+
+	if reasons := deploymentConditionReasons(deployment); len(reasons) != 0 {
+		t.Errorf("expected no reasons for a healthy deployment, got %+v", reasons)
+	}
+}
+
+func TestStatefulSetPVCReasons(t *testing.T) {
+	replicas := int32(2)
+	statefulSet := statefulSetWorkload{
+		StatefulSet: &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "ns"},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: &replicas,
+				VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+					{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+				},
+			},
+		},
+	}
+
+	boundPVC := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "data-db-0", Namespace: "ns"},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+	}
+	pendingPVC := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "data-db-1", Namespace: "ns"},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+	}
+	kubeClient := fake.NewSimpleClientset(boundPVC, pendingPVC)
+
+	reasons := statefulSetPVCReasons(context.Background(), statefulSet, kubeClient)
+	if len(reasons) != 1 {
+		t.Fatalf("expected 1 reason for the pending PVC, got %d: %+v", len(reasons), reasons)
+	}
+	if reasons[0].Reason != "PersistentVolumeClaimNotBound" {
+		t.Errorf("expected reason PersistentVolumeClaimNotBound, got %q", reasons[0].Reason)
+	}
+}
+
+func TestStatefulSetPVCReasonsNotFoundIsNotDegraded(t *testing.T) {
+	replicas := int32(1)
+	statefulSet := statefulSetWorkload{
+		StatefulSet: &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "ns"},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: &replicas,
+				VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+					{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+				},
+			},
+		},
+	}
+	kubeClient := fake.NewSimpleClientset()
+
+	if reasons := statefulSetPVCReasons(context.Background(), statefulSet, kubeClient); len(reasons) != 0 {
+		t.Errorf("expected a not-yet-provisioned PVC to not be reported as degraded, got %+v", reasons)
+	}
+}
+
+func TestStatefulSetPVCReasonsGetErrorIsDegraded(t *testing.T) {
+	replicas := int32(1)
+	statefulSet := statefulSetWorkload{
+		StatefulSet: &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "ns"},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: &replicas,
+				VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+					{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+				},
+			},
+		},
+	}
+	kubeClient := fake.NewSimpleClientset()
+	kubeClient.PrependReactor("get", "persistentvolumeclaims", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewInternalError(errors.New("etcd unavailable"))
+	})
+
+	reasons := statefulSetPVCReasons(context.Background(), statefulSet, kubeClient)
+	if len(reasons) != 1 {
+		t.Fatalf("expected a non-NotFound Get error to surface as a degraded reason, got %d: %+v", len(reasons), reasons)
+	}
+	if reasons[0].Reason != "PersistentVolumeClaimNotBound" {
+		t.Errorf("expected reason PersistentVolumeClaimNotBound, got %q", reasons[0].Reason)
+	}
+}
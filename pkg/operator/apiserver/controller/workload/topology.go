@@ -0,0 +1,159 @@
+package workload
+
+import (
+	"errors"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PodSpreadStrategy selects which scheduling mechanism EnsurePodSpread
+// applies to keep a workload's pods from piling onto the same node or zone.
+type PodSpreadStrategy int
+
+const (
+	// SpreadOnePerNodeHard requires at most one pod per node, refusing to
+	// schedule a pod rather than co-locating it with another (see
+	// EnsureAtMostOnePodPerNode).
+	SpreadOnePerNodeHard PodSpreadStrategy = iota
+	// SpreadOnePerZoneSoft prefers, but doesn't require, at most one pod per
+	// zone (see EnsureZoneSpread).
+	SpreadOnePerZoneSoft
+	// SpreadEvenMaxSkewOne evenly spreads pods across nodes with maxSkew=1,
+	// refusing to schedule a pod that would make the skew worse (see
+	// EnsureTopologySpreadConstraints).
+	SpreadEvenMaxSkewOne
+)
+
+// EnsurePodSpread mutates spec according to strategy, so callers can pick
+// "one-per-node hard", "one-per-zone soft" or "even spread with maxSkew=1"
+// without having to know which affinity or topologySpreadConstraints
+// mechanism backs each option.
+func EnsurePodSpread(spec *appsv1.DeploymentSpec, component string, strategy PodSpreadStrategy) error {
+	switch strategy {
+	case SpreadOnePerNodeHard:
+		return EnsureAtMostOnePodPerNode(spec, component)
+	case SpreadOnePerZoneSoft:
+		return EnsureZoneSpread(spec, component)
+	case SpreadEvenMaxSkewOne:
+		return EnsureTopologySpreadConstraints(spec, component, "kubernetes.io/hostname", 1, corev1.DoNotSchedule)
+	default:
+		return fmt.Errorf("unknown pod spread strategy %d", strategy)
+	}
+}
+
+// EnsurePreferredPodSpread is the soft counterpart to
+// EnsureAtMostOnePodPerNode: it adds a preferred, rather than required, pod
+// anti-affinity term on the hostname topology key, so the scheduler tries to
+// spread pods across nodes but won't refuse to schedule when it can't.
+// Re-invoking on an already-mutated spec updates the existing term in place
+// instead of appending a duplicate.
+func EnsurePreferredPodSpread(spec *appsv1.DeploymentSpec, component string) error {
+	if len(component) == 0 {
+		return errors.New("please specify the component name")
+	}
+	matchLabels, err := antiAffinityMatchLabels(spec, component)
+	if err != nil {
+		return err
+	}
+
+	term := corev1.WeightedPodAffinityTerm{
+		Weight: 100,
+		PodAffinityTerm: corev1.PodAffinityTerm{
+			TopologyKey:   "kubernetes.io/hostname",
+			LabelSelector: &metav1.LabelSelector{MatchLabels: matchLabels},
+		},
+	}
+
+	if spec.Template.Spec.Affinity == nil {
+		spec.Template.Spec.Affinity = &corev1.Affinity{}
+	}
+	if spec.Template.Spec.Affinity.PodAntiAffinity == nil {
+		spec.Template.Spec.Affinity.PodAntiAffinity = &corev1.PodAntiAffinity{}
+	}
+
+	preferred := spec.Template.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+	for i, existing := range preferred {
+		if existing.PodAffinityTerm.TopologyKey == term.PodAffinityTerm.TopologyKey {
+			preferred[i] = term
+			return nil
+		}
+	}
+	spec.Template.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(preferred, term)
+	return nil
+}
+
+// EnsureZoneSpread adds a soft, "one-per-zone" topologySpreadConstraint on
+// the well-known zone label, using ScheduleAnyway so it expresses a
+// preference rather than a hard scheduling requirement.
+func EnsureZoneSpread(spec *appsv1.DeploymentSpec, component string) error {
+	return EnsureTopologySpreadConstraints(spec, component, corev1.LabelTopologyZone, 1, corev1.ScheduleAnyway)
+}
+
+// EnsureTopologySpreadConstraints ensures spec.Template carries a
+// topologySpreadConstraint for topologyKey with the given maxSkew and
+// whenUnsatisfiable behavior (K8s 1.19+ topologySpreadConstraints), labeling
+// the pod template deterministically so the constraint's selector is stable
+// across invocations. Re-invoking on an already-mutated spec updates the
+// existing constraint for that topology key in place instead of appending a
+// duplicate.
+func EnsureTopologySpreadConstraints(spec *appsv1.DeploymentSpec, component, topologyKey string, maxSkew int32, whenUnsatisfiable corev1.UnsatisfiableConstraintAction) error {
+	if len(component) == 0 {
+		return errors.New("please specify the component name")
+	}
+	matchLabels, err := spreadMatchLabels(spec, component)
+	if err != nil {
+		return err
+	}
+
+	constraint := corev1.TopologySpreadConstraint{
+		TopologyKey:       topologyKey,
+		MaxSkew:           maxSkew,
+		WhenUnsatisfiable: whenUnsatisfiable,
+		LabelSelector:     &metav1.LabelSelector{MatchLabels: matchLabels},
+	}
+
+	for i, existing := range spec.Template.Spec.TopologySpreadConstraints {
+		if existing.TopologyKey == topologyKey {
+			spec.Template.Spec.TopologySpreadConstraints[i] = constraint
+			return nil
+		}
+	}
+	spec.Template.Spec.TopologySpreadConstraints = append(spec.Template.Spec.TopologySpreadConstraints, constraint)
+	return nil
+}
+
+// antiAffinityMatchLabels labels spec.Template with a deterministic
+// "<component>-anti-affinity" label and returns the match labels an
+// affinity term should select on: that label plus the deployment's own
+// selector labels.
+func antiAffinityMatchLabels(spec *appsv1.DeploymentSpec, component string) (map[string]string, error) {
+	return componentSpreadMatchLabels(spec, fmt.Sprintf("%s-anti-affinity", component))
+}
+
+// spreadMatchLabels labels spec.Template with a deterministic
+// "<component>-topology-spread" label and returns the match labels a
+// topologySpreadConstraint should select on: that label plus the
+// deployment's own selector labels.
+func spreadMatchLabels(spec *appsv1.DeploymentSpec, component string) (map[string]string, error) {
+	return componentSpreadMatchLabels(spec, fmt.Sprintf("%s-topology-spread", component))
+}
+
+func componentSpreadMatchLabels(spec *appsv1.DeploymentSpec, labelKey string) (map[string]string, error) {
+	if spec.Selector == nil {
+		return nil, fmt.Errorf("deployment is missing spec.selector")
+	}
+	if len(spec.Selector.MatchLabels) == 0 {
+		return nil, fmt.Errorf("deployment is missing spec.selector.matchLabels")
+	}
+
+	spec.Template.Labels[labelKey] = "true"
+
+	matchLabels := map[string]string{labelKey: "true"}
+	for key, value := range spec.Selector.MatchLabels {
+		matchLabels[key] = value
+	}
+	return matchLabels, nil
+}
@@ -0,0 +1,116 @@
+package workload
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newDeploymentSpec() *appsv1.DeploymentSpec {
+	return &appsv1.DeploymentSpec{
+		Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "example"}},
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "example"}},
+		},
+	}
+}
+
+func TestEnsureTopologySpreadConstraintsIsIdempotent(t *testing.T) {
+	spec := newDeploymentSpec()
+
+	if err := EnsureTopologySpreadConstraints(spec, "example", "kubernetes.io/hostname", 1, corev1.DoNotSchedule); err != nil {
+		t.Fatalf("unexpected error on first call: %v", err)
+	}
+	if err := EnsureTopologySpreadConstraints(spec, "example", "kubernetes.io/hostname", 2, corev1.ScheduleAnyway); err != nil {
+		t.Fatalf("unexpected error on second call: %v", err)
+	}
+
+	constraints := spec.Template.Spec.TopologySpreadConstraints
+	if len(constraints) != 1 {
+		t.Fatalf("expected re-invocation to update the existing constraint in place, got %d constraints: %+v", len(constraints), constraints)
+	}
+	if constraints[0].MaxSkew != 2 || constraints[0].WhenUnsatisfiable != corev1.ScheduleAnyway {
+		t.Errorf("expected the second call's values to win, got %+v", constraints[0])
+	}
+}
+
+func TestEnsureTopologySpreadConstraintsDistinctTopologyKeysDontCollide(t *testing.T) {
+	spec := newDeploymentSpec()
+
+	if err := EnsureTopologySpreadConstraints(spec, "example", "kubernetes.io/hostname", 1, corev1.DoNotSchedule); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := EnsureTopologySpreadConstraints(spec, "example", corev1.LabelTopologyZone, 1, corev1.ScheduleAnyway); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := len(spec.Template.Spec.TopologySpreadConstraints); got != 2 {
+		t.Errorf("expected 2 distinct constraints for 2 distinct topology keys, got %d", got)
+	}
+}
+
+func TestEnsureTopologySpreadConstraintsRequiresComponent(t *testing.T) {
+	spec := newDeploymentSpec()
+	if err := EnsureTopologySpreadConstraints(spec, "", "kubernetes.io/hostname", 1, corev1.DoNotSchedule); err == nil {
+		t.Error("expected an error when component is empty")
+	}
+}
+
+func TestEnsurePreferredPodSpreadIsIdempotent(t *testing.T) {
+	spec := newDeploymentSpec()
+
+	if err := EnsurePreferredPodSpread(spec, "example"); err != nil {
+		t.Fatalf("unexpected error on first call: %v", err)
+	}
+	if err := EnsurePreferredPodSpread(spec, "example"); err != nil {
+		t.Fatalf("unexpected error on second call: %v", err)
+	}
+
+	preferred := spec.Template.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+	if len(preferred) != 1 {
+		t.Fatalf("expected re-invocation to update the existing term in place, got %d terms: %+v", len(preferred), preferred)
+	}
+}
+
+func TestEnsurePodSpreadDispatchesByStrategy(t *testing.T) {
+	tests := []struct {
+		name             string
+		strategy         PodSpreadStrategy
+		wantAntiAffinity bool
+		wantConstraints  bool
+	}{
+		{name: "hard one-per-node", strategy: SpreadOnePerNodeHard, wantAntiAffinity: true},
+		{name: "soft one-per-zone", strategy: SpreadOnePerZoneSoft, wantConstraints: true},
+		{name: "even maxSkew=1", strategy: SpreadEvenMaxSkewOne, wantConstraints: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := newDeploymentSpec()
+			if err := EnsurePodSpread(spec, "example", tc.strategy); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			hasAntiAffinity := spec.Template.Spec.Affinity != nil &&
+				spec.Template.Spec.Affinity.PodAntiAffinity != nil &&
+				len(spec.Template.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution) > 0
+			hasConstraints := len(spec.Template.Spec.TopologySpreadConstraints) > 0
+
+			if hasAntiAffinity != tc.wantAntiAffinity {
+				t.Errorf("wantAntiAffinity=%v, got %v", tc.wantAntiAffinity, hasAntiAffinity)
+			}
+			if hasConstraints != tc.wantConstraints {
+				t.Errorf("wantConstraints=%v, got %v", tc.wantConstraints, hasConstraints)
+			}
+		})
+	}
+}
+
+func TestEnsurePodSpreadUnknownStrategy(t *testing.T) {
+	spec := newDeploymentSpec()
+	if err := EnsurePodSpread(spec, "example", PodSpreadStrategy(99)); err == nil {
+		t.Error("expected an error for an unknown strategy")
+	}
+}
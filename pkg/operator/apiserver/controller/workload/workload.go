@@ -19,6 +19,7 @@ import (
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	openshiftconfigclientv1 "github.com/openshift/client-go/config/clientset/versioned/typed/config/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
 	"github.com/openshift/library-go/pkg/apps/deployment"
 	"github.com/openshift/library-go/pkg/controller/factory"
 	"github.com/openshift/library-go/pkg/operator/events"
@@ -34,7 +35,7 @@ const (
 // Delegate captures a set of methods that hold a custom logic
 type Delegate interface {
 	// Sync a method that will be used for delegation. It should bring the desired workload into operation.
-	Sync(ctx context.Context, controllerContext factory.SyncContext) (*appsv1.Deployment, bool, []error)
+	Sync(ctx context.Context, controllerContext factory.SyncContext) (Workload, bool, []error)
 
 	// PreconditionFulfilled a method that indicates whether all prerequisites are met and we can Sync.
 	//
@@ -44,7 +45,55 @@ type Delegate interface {
 	PreconditionFulfilled() (bool, error)
 }
 
-// Controller is a generic workload controller that deals with Deployment resource.
+// ControllerOption customizes optional Controller behavior at construction
+// time without growing NewController's parameter list.
+type ControllerOption func(*Controller)
+
+// WithWorkloadKind configures which workload kind (DeploymentKind,
+// DaemonSetKind or StatefulSetKind) this controller's Delegate manages. It is
+// used to name the operator conditions the controller maintains, for example
+// "<prefix>DaemonSetAvailable". It defaults to DeploymentKind.
+func WithWorkloadKind(kind WorkloadKind) ControllerOption {
+	return func(c *Controller) {
+		c.workloadKind = kind
+	}
+}
+
+// WithReadinessChecker overrides the ReadinessChecker used to compute deep
+// degraded reasons beyond AvailableReplicas/UpdatedReplicas/
+// ObservedGeneration. It defaults to NewHelmStyleReadinessChecker().
+func WithReadinessChecker(checker ReadinessChecker) ControllerOption {
+	return func(c *Controller) {
+		c.readinessChecker = checker
+	}
+}
+
+// WithFieldManager overrides the field manager used when the operator client
+// supports applying status via server-side apply. It defaults to
+// "<name>WorkloadController". Operators that run more than one workload
+// controller against the same operator status should give each a distinct
+// field manager so their conditions don't clobber one another.
+func WithFieldManager(fieldManager string) ControllerOption {
+	return func(c *Controller) {
+		c.fieldManager = fieldManager
+	}
+}
+
+// WithStatusApplyConfiguration registers a builder that further customizes
+// the OperatorStatusApplyConfiguration applyStatus sends on every sync,
+// after it has set the conditions and generations this controller owns. Use
+// it when the operator client supports server-side apply and the caller
+// needs this controller's field manager to also own additional status
+// fields. It has no effect when the operator client falls back to the
+// legacy read-modify-write path (see applyStatus).
+func WithStatusApplyConfiguration(builder func(*applyoperatorv1.OperatorStatusApplyConfiguration) *applyoperatorv1.OperatorStatusApplyConfiguration) ControllerOption {
+	return func(c *Controller) {
+		c.statusApplyConfigurationBuilder = builder
+	}
+}
+
+// Controller is a generic workload controller that deals with a Deployment,
+// DaemonSet or StatefulSet resource.
 // Callers must provide a sync function for delegation. It should bring the desired workload into operation.
 // The returned state along with errors will be converted into conditions and persisted in the status field.
 type Controller struct {
@@ -55,6 +104,19 @@ type Controller struct {
 	targetOperandVersion string
 	// operandNamePrefix is used to set the version for an operand via versionRecorder.SetVersion method
 	operandNamePrefix string
+	// workloadKind is the kind of workload (Deployment, DaemonSet, StatefulSet) the delegate manages.
+	workloadKind WorkloadKind
+	// fieldManager is used when persisting status through server-side apply.
+	fieldManager string
+	// statusApplyConfigurationBuilder is set by WithStatusApplyConfiguration;
+	// nil unless a caller opted in.
+	statusApplyConfigurationBuilder func(*applyoperatorv1.OperatorStatusApplyConfiguration) *applyoperatorv1.OperatorStatusApplyConfiguration
+	// readinessChecker computes deep degraded reasons from the workload and its pods.
+	readinessChecker ReadinessChecker
+	// nodeCountReplicas is set by WithNodeCountReplicas; nil unless a caller opted in.
+	nodeCountReplicas *nodeCountReplicas
+	// extraInformers are added by ControllerOptions (e.g. WithNodeCountReplicas) on top of the caller-supplied informers.
+	extraInformers []factory.Informer
 
 	podsLister corev1listers.PodLister
 
@@ -86,6 +148,7 @@ func NewController(name, operatorNamespace, targetNamespace, targetOperandVersio
 	openshiftClusterConfigClient openshiftconfigclientv1.ClusterOperatorInterface,
 	eventRecorder events.Recorder,
 	versionRecorder status.VersionGetter,
+	opts ...ControllerOption,
 ) factory.Controller {
 	controllerRef := &Controller{
 		operatorNamespace:            operatorNamespace,
@@ -93,6 +156,9 @@ func NewController(name, operatorNamespace, targetNamespace, targetOperandVersio
 		targetOperandVersion:         targetOperandVersion,
 		operandNamePrefix:            operandNamePrefix,
 		conditionsPrefix:             conditionsPrefix,
+		workloadKind:                 DeploymentKind,
+		fieldManager:                 fmt.Sprintf("%sWorkloadController", name),
+		readinessChecker:             NewHelmStyleReadinessChecker(),
 		operatorClient:               operatorClient,
 		kubeClient:                   kubeClient,
 		podsLister:                   podLister,
@@ -102,13 +168,17 @@ func NewController(name, operatorNamespace, targetNamespace, targetOperandVersio
 		queue:                        workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), name),
 	}
 
+	for _, opt := range opts {
+		opt(controllerRef)
+	}
+
 	c := factory.New()
 	for _, nsi := range tagetNamespaceInformers {
 		c.WithNamespaceInformer(nsi, targetNamespace)
 	}
 
 	return c.WithSync(controllerRef.sync).
-		WithInformers(informers...).
+		WithInformers(append(informers, controllerRef.extraInformers...)...).
 		ToController(fmt.Sprintf("%sWorkloadController", name), eventRecorder)
 }
 
@@ -123,12 +193,12 @@ func (c *Controller) sync(ctx context.Context, controllerContext factory.SyncCon
 	}
 
 	if fulfilled, err := c.delegate.PreconditionFulfilled(); !fulfilled || err != nil {
-		return c.updateOperatorStatus(nil, false, false, []error{err})
+		return c.updateOperatorStatus(ctx, nil, false, false, []error{err})
 	}
 
 	workload, operatorConfigAtHighestGeneration, errs := c.delegate.Sync(ctx, controllerContext)
 
-	return c.updateOperatorStatus(workload, operatorConfigAtHighestGeneration, true, errs)
+	return c.updateOperatorStatus(ctx, workload, operatorConfigAtHighestGeneration, true, errs)
 }
 
 // shouldSync checks ManagementState to determine if we can run this operator, probably set by a cluster administrator.
@@ -150,30 +220,26 @@ func (c *Controller) shouldSync(ctx context.Context, operatorSpec *operatorv1.Op
 }
 
 // updateOperatorStatus updates the status based on the actual workload and errors that might have occurred during synchronization.
-func (c *Controller) updateOperatorStatus(workload *appsv1.Deployment, operatorConfigAtHighestGeneration bool, preconditionsReady bool, errs []error) error {
+func (c *Controller) updateOperatorStatus(ctx context.Context, workload Workload, operatorConfigAtHighestGeneration bool, preconditionsReady bool, errs []error) error {
 	if errs == nil {
 		errs = []error{}
 	}
 
-	deploymentAvailableCondition := operatorv1.OperatorCondition{
-		Type:   fmt.Sprintf("%sDeployment%s", c.conditionsPrefix, operatorv1.OperatorStatusTypeAvailable),
-		Status: operatorv1.ConditionTrue,
-	}
+	workloadAvailableCondition := applyoperatorv1.OperatorCondition().
+		WithType(fmt.Sprintf("%s%s%s", c.conditionsPrefix, c.workloadKind, operatorv1.OperatorStatusTypeAvailable)).
+		WithStatus(operatorv1.ConditionTrue)
 
-	workloadDegradedCondition := operatorv1.OperatorCondition{
-		Type:   fmt.Sprintf("%sWorkloadDegraded", c.conditionsPrefix),
-		Status: operatorv1.ConditionFalse,
-	}
+	workloadDegradedCondition := applyoperatorv1.OperatorCondition().
+		WithType(fmt.Sprintf("%sWorkloadDegraded", c.conditionsPrefix)).
+		WithStatus(operatorv1.ConditionFalse)
 
-	deploymentDegradedCondition := operatorv1.OperatorCondition{
-		Type:   fmt.Sprintf("%sDeploymentDegraded", c.conditionsPrefix),
-		Status: operatorv1.ConditionFalse,
-	}
+	workloadKindDegradedCondition := applyoperatorv1.OperatorCondition().
+		WithType(fmt.Sprintf("%s%sDegraded", c.conditionsPrefix, c.workloadKind)).
+		WithStatus(operatorv1.ConditionFalse)
 
-	deploymentProgressingCondition := operatorv1.OperatorCondition{
-		Type:   fmt.Sprintf("%sDeployment%s", c.conditionsPrefix, operatorv1.OperatorStatusTypeProgressing),
-		Status: operatorv1.ConditionFalse,
-	}
+	workloadProgressingCondition := applyoperatorv1.OperatorCondition().
+		WithType(fmt.Sprintf("%s%s%s", c.conditionsPrefix, c.workloadKind, operatorv1.OperatorStatusTypeProgressing)).
+		WithStatus(operatorv1.ConditionFalse)
 
 	if !preconditionsReady {
 		var message string
@@ -186,21 +252,13 @@ func (c *Controller) updateOperatorStatus(workload *appsv1.Deployment, operatorC
 
 		// we are degraded, not available and we are not progressing
 
-		deploymentDegradedCondition.Status = operatorv1.ConditionTrue
-		deploymentDegradedCondition.Reason = "PreconditionNotFulfilled"
-		deploymentDegradedCondition.Message = message
-
-		deploymentAvailableCondition.Status = operatorv1.ConditionFalse
-		deploymentAvailableCondition.Reason = "PreconditionNotFulfilled"
-
-		deploymentProgressingCondition.Status = operatorv1.ConditionFalse
-		deploymentProgressingCondition.Reason = "PreconditionNotFulfilled"
+		workloadKindDegradedCondition.WithStatus(operatorv1.ConditionTrue).WithReason("PreconditionNotFulfilled").WithMessage(message)
+		workloadAvailableCondition.WithStatus(operatorv1.ConditionFalse).WithReason("PreconditionNotFulfilled")
+		workloadProgressingCondition.WithStatus(operatorv1.ConditionFalse).WithReason("PreconditionNotFulfilled")
 
-		if _, _, updateError := v1helpers.UpdateStatus(c.operatorClient,
-			v1helpers.UpdateConditionFn(deploymentAvailableCondition),
-			v1helpers.UpdateConditionFn(deploymentDegradedCondition),
-			v1helpers.UpdateConditionFn(deploymentProgressingCondition),
-			v1helpers.UpdateConditionFn(workloadDegradedCondition)); updateError != nil {
+		if updateError := c.applyStatus(ctx, []*applyoperatorv1.OperatorConditionApplyConfiguration{
+			workloadAvailableCondition, workloadKindDegradedCondition, workloadProgressingCondition, workloadDegradedCondition,
+		}, nil); updateError != nil {
 			return updateError
 		}
 		return kerrors.NewAggregate(errs)
@@ -211,100 +269,97 @@ func (c *Controller) updateOperatorStatus(workload *appsv1.Deployment, operatorC
 		for _, err := range errs {
 			message = message + err.Error() + "\n"
 		}
-		workloadDegradedCondition.Status = operatorv1.ConditionTrue
-		workloadDegradedCondition.Reason = "SyncError"
-		workloadDegradedCondition.Message = message
+		workloadDegradedCondition.WithStatus(operatorv1.ConditionTrue).WithReason("SyncError").WithMessage(message)
 	} else {
-		workloadDegradedCondition.Status = operatorv1.ConditionFalse
+		workloadDegradedCondition.WithStatus(operatorv1.ConditionFalse)
 	}
 
 	if workload == nil {
-		message := fmt.Sprintf("deployment/%s: could not be retrieved", c.targetNamespace)
-		deploymentAvailableCondition.Status = operatorv1.ConditionFalse
-		deploymentAvailableCondition.Reason = "NoDeployment"
-		deploymentAvailableCondition.Message = message
-
-		deploymentProgressingCondition.Status = operatorv1.ConditionTrue
-		deploymentProgressingCondition.Reason = "NoDeployment"
-		deploymentProgressingCondition.Message = message
-
-		deploymentDegradedCondition.Status = operatorv1.ConditionTrue
-		deploymentDegradedCondition.Reason = "NoDeployment"
-		deploymentDegradedCondition.Message = message
-
-		if _, _, updateError := v1helpers.UpdateStatus(c.operatorClient,
-			v1helpers.UpdateConditionFn(deploymentAvailableCondition),
-			v1helpers.UpdateConditionFn(deploymentDegradedCondition),
-			v1helpers.UpdateConditionFn(deploymentProgressingCondition),
-			v1helpers.UpdateConditionFn(workloadDegradedCondition)); updateError != nil {
+		message := fmt.Sprintf("%s/%s: could not be retrieved", strings.ToLower(string(c.workloadKind)), c.targetNamespace)
+
+		if containsErrWorkloadNotFound(errs) {
+			// The delegate looked and the resource is genuinely absent, as
+			// opposed to merely unreadable because of an API error.
+			workloadAvailableCondition.WithStatus(operatorv1.ConditionFalse).WithReason("ResourceNotFound").WithMessage(message)
+			workloadProgressingCondition.WithStatus(operatorv1.ConditionFalse).WithReason("ResourceNotFound").WithMessage(message)
+			workloadKindDegradedCondition.WithStatus(operatorv1.ConditionTrue).WithReason("ResourceNotFound").WithMessage(message)
+		} else {
+			// The workload couldn't be read; preserve the last known good
+			// Available condition instead of flipping it to False.
+			if existing := c.currentCondition(*workloadAvailableCondition.Type); existing != nil {
+				workloadAvailableCondition.WithStatus(existing.Status).WithReason(existing.Reason).WithMessage(existing.Message)
+			} else {
+				workloadAvailableCondition.WithStatus(operatorv1.ConditionFalse).WithReason("SyncFailed").WithMessage(message)
+			}
+			workloadProgressingCondition.WithStatus(operatorv1.ConditionTrue).WithReason("SyncFailed").WithMessage(message)
+			workloadKindDegradedCondition.WithStatus(operatorv1.ConditionTrue).WithReason("SyncFailed").WithMessage(message)
+		}
+
+		if updateError := c.applyStatus(ctx, []*applyoperatorv1.OperatorConditionApplyConfiguration{
+			workloadAvailableCondition, workloadKindDegradedCondition, workloadProgressingCondition, workloadDegradedCondition,
+		}, nil); updateError != nil {
 			return updateError
 		}
 		return kerrors.NewAggregate(errs)
 	}
 
-	if workload.Status.AvailableReplicas == 0 {
-		deploymentAvailableCondition.Status = operatorv1.ConditionFalse
-		deploymentAvailableCondition.Reason = "NoPod"
-		deploymentAvailableCondition.Message = fmt.Sprintf("no %s.%s pods available on any node.", workload.Name, c.targetNamespace)
+	if workload.AvailableReplicas() == 0 {
+		workloadAvailableCondition.WithStatus(operatorv1.ConditionFalse).WithReason("NoPod").
+			WithMessage(fmt.Sprintf("no %s.%s pods available on any node.", workload.GetName(), c.targetNamespace))
 	} else {
-		deploymentAvailableCondition.Status = operatorv1.ConditionTrue
-		deploymentAvailableCondition.Reason = "AsExpected"
+		workloadAvailableCondition.WithStatus(operatorv1.ConditionTrue).WithReason("AsExpected")
 	}
 
 	// If the workload is up to date, then we are no longer progressing
-	workloadAtHighestGeneration := workload.ObjectMeta.Generation == workload.Status.ObservedGeneration
+	workloadAtHighestGeneration := workload.GetGeneration() == workload.ObservedGeneration()
 	if !workloadAtHighestGeneration {
-		deploymentProgressingCondition.Status = operatorv1.ConditionTrue
-		deploymentProgressingCondition.Reason = "NewGeneration"
-		deploymentProgressingCondition.Message = fmt.Sprintf("deployment/%s.%s: observed generation is %d, desired generation is %d.", workload.Name, c.targetNamespace, workload.Status.ObservedGeneration, workload.ObjectMeta.Generation)
+		workloadProgressingCondition.WithStatus(operatorv1.ConditionTrue).WithReason("NewGeneration").
+			WithMessage(fmt.Sprintf("%s/%s.%s: observed generation is %d, desired generation is %d.", strings.ToLower(string(c.workloadKind)), workload.GetName(), c.targetNamespace, workload.ObservedGeneration(), workload.GetGeneration()))
 	} else {
-		deploymentProgressingCondition.Status = operatorv1.ConditionFalse
-		deploymentProgressingCondition.Reason = "AsExpected"
+		workloadProgressingCondition.WithStatus(operatorv1.ConditionFalse).WithReason("AsExpected")
 	}
 
-	desiredReplicas := int32(1)
-	if workload.Spec.Replicas != nil {
-		desiredReplicas = *(workload.Spec.Replicas)
-	}
+	desiredReplicas := workload.DesiredReplicas()
 
 	// During a rollout the default maxSurge (25%) will allow the available
 	// replicas to temporarily exceed the desired replica count. If this were
 	// to occur, the operator should not report degraded.
-	workloadHasAllPodsAvailable := workload.Status.AvailableReplicas >= desiredReplicas
-	if !workloadHasAllPodsAvailable {
-		numNonAvailablePods := desiredReplicas - workload.Status.AvailableReplicas
-		deploymentDegradedCondition.Status = operatorv1.ConditionTrue
-		deploymentDegradedCondition.Reason = "UnavailablePod"
-		podContainersStatus, err := deployment.PodContainersStatus(workload, c.podsLister)
-		if err != nil {
-			podContainersStatus = []string{fmt.Sprintf("failed to get pod containers details: %v", err)}
+	workloadHasAllPodsAvailable := workload.AvailableReplicas() >= desiredReplicas
+
+	degradedReasons := c.readinessChecker.CheckReadiness(ctx, workload, c.podsLister, c.kubeClient)
+	switch {
+	case len(degradedReasons) > 0:
+		workloadKindDegradedCondition.WithStatus(operatorv1.ConditionTrue).
+			WithReason(degradedReasons[0].Reason).
+			WithMessage(degradedReasonsMessage(degradedReasons))
+	case !workloadHasAllPodsAvailable:
+		numNonAvailablePods := desiredReplicas - workload.AvailableReplicas()
+		var podContainersStatus []string
+		if dw, ok := workload.(deploymentWorkload); ok {
+			var err error
+			podContainersStatus, err = deployment.PodContainersStatus(dw.Deployment, c.podsLister)
+			if err != nil {
+				podContainersStatus = []string{fmt.Sprintf("failed to get pod containers details: %v", err)}
+			}
 		}
-		deploymentDegradedCondition.Message = fmt.Sprintf("%v of %v requested instances are unavailable for %s.%s (%s)", numNonAvailablePods, desiredReplicas, workload.Name, c.targetNamespace,
-			strings.Join(podContainersStatus, ", "))
-	} else {
-		deploymentDegradedCondition.Status = operatorv1.ConditionFalse
-		deploymentDegradedCondition.Reason = "AsExpected"
+		workloadKindDegradedCondition.WithStatus(operatorv1.ConditionTrue).WithReason("UnavailablePod").
+			WithMessage(fmt.Sprintf("%v of %v requested instances are unavailable for %s.%s (%s)", numNonAvailablePods, desiredReplicas, workload.GetName(), c.targetNamespace,
+				strings.Join(podContainersStatus, ", ")))
+	default:
+		workloadKindDegradedCondition.WithStatus(operatorv1.ConditionFalse).WithReason("AsExpected")
 	}
 
-	// if the deployment is all available and at the expected generation, then update the version to the latest
-	// when we update, the image pull spec should immediately be different, which should immediately cause a deployment rollout
-	// which should immediately result in a deployment generation diff, which should cause this block to be skipped until it is ready.
-	workloadHasAllPodsUpdated := workload.Status.UpdatedReplicas == desiredReplicas
+	// if the workload is all available and at the expected generation, then update the version to the latest
+	// when we update, the image pull spec should immediately be different, which should immediately cause a rollout
+	// which should immediately result in a generation diff, which should cause this block to be skipped until it is ready.
+	workloadHasAllPodsUpdated := workload.UpdatedReplicas() == desiredReplicas
 	if workloadAtHighestGeneration && workloadHasAllPodsAvailable && workloadHasAllPodsUpdated && operatorConfigAtHighestGeneration {
-		c.versionRecorder.SetVersion(fmt.Sprintf("%s-%s", c.operandNamePrefix, workload.Name), c.targetOperandVersion)
+		c.versionRecorder.SetVersion(fmt.Sprintf("%s-%s", c.operandNamePrefix, workload.GetName()), c.targetOperandVersion)
 	}
 
-	updateGenerationFn := func(newStatus *operatorv1.OperatorStatus) error {
-		resourcemerge.SetDeploymentGeneration(&newStatus.Generations, workload)
-		return nil
-	}
-
-	if _, _, updateError := v1helpers.UpdateStatus(c.operatorClient,
-		v1helpers.UpdateConditionFn(deploymentAvailableCondition),
-		v1helpers.UpdateConditionFn(deploymentDegradedCondition),
-		v1helpers.UpdateConditionFn(deploymentProgressingCondition),
-		v1helpers.UpdateConditionFn(workloadDegradedCondition),
-		updateGenerationFn); updateError != nil {
+	if updateError := c.applyStatus(ctx, []*applyoperatorv1.OperatorConditionApplyConfiguration{
+		workloadAvailableCondition, workloadKindDegradedCondition, workloadProgressingCondition, workloadDegradedCondition,
+	}, workload); updateError != nil {
 		return updateError
 	}
 
@@ -314,6 +369,123 @@ func (c *Controller) updateOperatorStatus(workload *appsv1.Deployment, operatorC
 	return nil
 }
 
+// containsErrWorkloadNotFound reports whether errs includes ErrWorkloadNotFound.
+func containsErrWorkloadNotFound(errs []error) bool {
+	for _, err := range errs {
+		if errors.Is(err, ErrWorkloadNotFound) {
+			return true
+		}
+	}
+	return false
+}
+
+// currentCondition returns the operator's currently persisted condition of
+// the given type, or nil if it can't be read or hasn't been set yet.
+func (c *Controller) currentCondition(conditionType string) *operatorv1.OperatorCondition {
+	_, currentStatus, _, err := c.operatorClient.GetOperatorState()
+	if err != nil || currentStatus == nil {
+		return nil
+	}
+	for i := range currentStatus.Conditions {
+		if currentStatus.Conditions[i].Type == conditionType {
+			return &currentStatus.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// statusApplyClient is the narrow capability a v1helpers.OperatorClient may
+// additionally implement to persist status via server-side apply. It's
+// asserted against directly, rather than against some broader capability
+// interface in v1helpers, so applyStatus's SSA-vs-legacy-shim split depends
+// only on the one method it actually calls.
+type statusApplyClient interface {
+	ApplyOperatorStatus(ctx context.Context, fieldManager string, applyConfiguration *applyoperatorv1.OperatorStatusApplyConfiguration) error
+}
+
+// applyStatus persists the given conditions, and the workload's generation
+// when workload is non-nil, as a single field-manager scoped server-side
+// apply call when the operator client implements statusApplyClient. This
+// lets multiple controllers writing overlapping conditions do so without
+// clobbering each other.
+//
+// Operator clients that don't implement that interface fall back to the
+// legacy read-modify-write v1helpers.UpdateStatus path, so existing callers
+// built against plain v1helpers.OperatorClient keep working unchanged.
+func (c *Controller) applyStatus(ctx context.Context, conditions []*applyoperatorv1.OperatorConditionApplyConfiguration, workload Workload) error {
+	if applyClient, ok := c.operatorClient.(statusApplyClient); ok {
+		status := applyoperatorv1.OperatorStatus().WithConditions(conditions...)
+		if workload != nil {
+			status = status.WithGenerations(c.generationApplyConfiguration(workload))
+		}
+		if c.statusApplyConfigurationBuilder != nil {
+			status = c.statusApplyConfigurationBuilder(status)
+		}
+		return applyClient.ApplyOperatorStatus(ctx, c.fieldManager, status)
+	}
+
+	updateFns := make([]v1helpers.UpdateStatusFunc, 0, len(conditions)+1)
+	for _, condition := range conditions {
+		updateFns = append(updateFns, v1helpers.UpdateConditionFn(toOperatorCondition(condition)))
+	}
+	if workload != nil {
+		updateFns = append(updateFns, func(newStatus *operatorv1.OperatorStatus) error {
+			switch w := workload.(type) {
+			case deploymentWorkload:
+				resourcemerge.SetDeploymentGeneration(&newStatus.Generations, w.Deployment)
+			case daemonSetWorkload:
+				resourcemerge.SetDaemonSetGeneration(&newStatus.Generations, w.DaemonSet)
+			case statefulSetWorkload:
+				resourcemerge.SetStatefulSetGeneration(&newStatus.Generations, w.StatefulSet)
+			}
+			return nil
+		})
+	}
+
+	_, _, updateError := v1helpers.UpdateStatus(c.operatorClient, updateFns...)
+	return updateError
+}
+
+// generationApplyConfiguration builds the GenerationStatus apply
+// configuration entry tracking workload's observed generation.
+func (c *Controller) generationApplyConfiguration(workload Workload) *applyoperatorv1.GenerationStatusApplyConfiguration {
+	var group, resource string
+	switch workload.(type) {
+	case deploymentWorkload:
+		group, resource = "apps", "deployments"
+	case daemonSetWorkload:
+		group, resource = "apps", "daemonsets"
+	case statefulSetWorkload:
+		group, resource = "apps", "statefulsets"
+	}
+
+	return applyoperatorv1.GenerationStatus().
+		WithGroup(group).
+		WithResource(resource).
+		WithNamespace(workload.GetNamespace()).
+		WithName(workload.GetName()).
+		WithLastGeneration(workload.GetGeneration())
+}
+
+// toOperatorCondition converts an OperatorCondition apply configuration back
+// into a plain operatorv1.OperatorCondition for the legacy UpdateStatus path.
+func toOperatorCondition(condition *applyoperatorv1.OperatorConditionApplyConfiguration) operatorv1.OperatorCondition {
+	cond := operatorv1.OperatorCondition{}
+	if condition.Type != nil {
+		cond.Type = *condition.Type
+	}
+	if condition.Status != nil {
+		cond.Status = *condition.Status
+	}
+	if condition.Reason != nil {
+		cond.Reason = *condition.Reason
+	}
+	if condition.Message != nil {
+		cond.Message = *condition.Message
+	}
+	return cond
+}
+
 // EnsureAtMostOnePodPerNode updates the deployment spec to prevent more than
 // one pod of a given replicaset from landing on a node. It accomplishes this
 // by adding a label on the template and updates the pod anti-affinity term to include that label.
@@ -0,0 +1,122 @@
+package workload
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkloadKind identifies the kind of resource a Controller drives to a
+// ready state. It is used both to pick the right status probes for a
+// workload and to name the operator conditions the controller manages.
+type WorkloadKind string
+
+const (
+	// DeploymentKind is used for replicated, stateless operands.
+	DeploymentKind WorkloadKind = "Deployment"
+	// DaemonSetKind is used for operands that run one pod per matching node.
+	DaemonSetKind WorkloadKind = "DaemonSet"
+	// StatefulSetKind is used for ordered, stateful operands.
+	StatefulSetKind WorkloadKind = "StatefulSet"
+)
+
+// Workload abstracts over the workload resource kinds (Deployment, DaemonSet
+// and StatefulSet) that Controller knows how to drive to a ready state. It
+// lets updateOperatorStatus compute conditions, version recording and
+// generation tracking without caring which concrete kind a Delegate manages.
+type Workload interface {
+	metav1.Object
+
+	// Kind returns the kind of the underlying workload.
+	Kind() WorkloadKind
+
+	// DesiredReplicas returns the number of replicas the workload is
+	// configured to run.
+	DesiredReplicas() int32
+
+	// AvailableReplicas returns the number of replicas currently available.
+	AvailableReplicas() int32
+
+	// UpdatedReplicas returns the number of replicas running the most
+	// up-to-date revision.
+	UpdatedReplicas() int32
+
+	// ObservedGeneration returns the generation last observed by the
+	// controller that manages the workload.
+	ObservedGeneration() int64
+
+	// Selector returns the label selector used to find pods owned by the
+	// workload, so a ReadinessChecker can walk them.
+	Selector() *metav1.LabelSelector
+}
+
+// deploymentWorkload adapts *appsv1.Deployment to the Workload interface.
+type deploymentWorkload struct {
+	*appsv1.Deployment
+}
+
+// NewDeploymentWorkload adapts a Deployment so it can be returned by a
+// Delegate and consumed by Controller.
+func NewDeploymentWorkload(deployment *appsv1.Deployment) Workload {
+	return deploymentWorkload{Deployment: deployment}
+}
+
+func (w deploymentWorkload) Kind() WorkloadKind { return DeploymentKind }
+
+func (w deploymentWorkload) DesiredReplicas() int32 {
+	if w.Spec.Replicas == nil {
+		return 1
+	}
+	return *w.Spec.Replicas
+}
+
+func (w deploymentWorkload) AvailableReplicas() int32        { return w.Status.AvailableReplicas }
+func (w deploymentWorkload) UpdatedReplicas() int32          { return w.Status.UpdatedReplicas }
+func (w deploymentWorkload) ObservedGeneration() int64       { return w.Status.ObservedGeneration }
+func (w deploymentWorkload) Selector() *metav1.LabelSelector { return w.Spec.Selector }
+
+// daemonSetWorkload adapts *appsv1.DaemonSet to the Workload interface.
+type daemonSetWorkload struct {
+	*appsv1.DaemonSet
+}
+
+// NewDaemonSetWorkload adapts a DaemonSet so it can be returned by a
+// Delegate and consumed by Controller.
+func NewDaemonSetWorkload(daemonSet *appsv1.DaemonSet) Workload {
+	return daemonSetWorkload{DaemonSet: daemonSet}
+}
+
+func (w daemonSetWorkload) Kind() WorkloadKind { return DaemonSetKind }
+
+// DesiredReplicas returns the number of nodes the DaemonSet is scheduled to
+// run on, since DaemonSets have no spec.replicas field.
+func (w daemonSetWorkload) DesiredReplicas() int32 { return w.Status.DesiredNumberScheduled }
+
+func (w daemonSetWorkload) AvailableReplicas() int32        { return w.Status.NumberAvailable }
+func (w daemonSetWorkload) UpdatedReplicas() int32          { return w.Status.UpdatedNumberScheduled }
+func (w daemonSetWorkload) ObservedGeneration() int64       { return w.Status.ObservedGeneration }
+func (w daemonSetWorkload) Selector() *metav1.LabelSelector { return w.Spec.Selector }
+
+// statefulSetWorkload adapts *appsv1.StatefulSet to the Workload interface.
+type statefulSetWorkload struct {
+	*appsv1.StatefulSet
+}
+
+// NewStatefulSetWorkload adapts a StatefulSet so it can be returned by a
+// Delegate and consumed by Controller.
+func NewStatefulSetWorkload(statefulSet *appsv1.StatefulSet) Workload {
+	return statefulSetWorkload{StatefulSet: statefulSet}
+}
+
+func (w statefulSetWorkload) Kind() WorkloadKind { return StatefulSetKind }
+
+func (w statefulSetWorkload) DesiredReplicas() int32 {
+	if w.Spec.Replicas == nil {
+		return 1
+	}
+	return *w.Spec.Replicas
+}
+
+func (w statefulSetWorkload) AvailableReplicas() int32        { return w.Status.AvailableReplicas }
+func (w statefulSetWorkload) UpdatedReplicas() int32          { return w.Status.UpdatedReplicas }
+func (w statefulSetWorkload) ObservedGeneration() int64       { return w.Status.ObservedGeneration }
+func (w statefulSetWorkload) Selector() *metav1.LabelSelector { return w.Spec.Selector }
@@ -0,0 +1,57 @@
+package workload
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDaemonSetWorkloadDesiredReplicasTracksScheduledNodes(t *testing.T) {
+	w := NewDaemonSetWorkload(&appsv1.DaemonSet{
+		Status: appsv1.DaemonSetStatus{
+			DesiredNumberScheduled: 3,
+			NumberAvailable:        2,
+			UpdatedNumberScheduled: 1,
+		},
+	})
+
+	if w.Kind() != DaemonSetKind {
+		t.Errorf("expected Kind() to be %q, got %q", DaemonSetKind, w.Kind())
+	}
+	if got := w.DesiredReplicas(); got != 3 {
+		t.Errorf("expected DesiredReplicas() to be the number of scheduled nodes (3), got %d", got)
+	}
+	if got := w.AvailableReplicas(); got != 2 {
+		t.Errorf("expected AvailableReplicas() 2, got %d", got)
+	}
+	if got := w.UpdatedReplicas(); got != 1 {
+		t.Errorf("expected UpdatedReplicas() 1, got %d", got)
+	}
+}
+
+func TestStatefulSetWorkloadDesiredReplicasDefaultsToOne(t *testing.T) {
+	w := NewStatefulSetWorkload(&appsv1.StatefulSet{})
+
+	if w.Kind() != StatefulSetKind {
+		t.Errorf("expected Kind() to be %q, got %q", StatefulSetKind, w.Kind())
+	}
+	if got := w.DesiredReplicas(); got != 1 {
+		t.Errorf("expected a nil spec.replicas to default to 1, got %d", got)
+	}
+
+	replicas := int32(5)
+	w = NewStatefulSetWorkload(&appsv1.StatefulSet{Spec: appsv1.StatefulSetSpec{Replicas: &replicas}})
+	if got := w.DesiredReplicas(); got != 5 {
+		t.Errorf("expected DesiredReplicas() to be 5, got %d", got)
+	}
+}
+
+func TestDeploymentWorkloadSelectorPassesThrough(t *testing.T) {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "example"}}
+	w := NewDeploymentWorkload(&appsv1.Deployment{Spec: appsv1.DeploymentSpec{Selector: selector}})
+
+	if w.Selector() != selector {
+		t.Errorf("expected Selector() to return the deployment's spec.selector unchanged")
+	}
+}
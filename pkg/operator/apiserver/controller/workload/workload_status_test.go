@@ -0,0 +1,210 @@
+package workload
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	applyoperatorv1 "github.com/openshift/client-go/operator/applyconfigurations/operator/v1"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+)
+
+// fakeApplyOperatorClient wraps a plain v1helpers.OperatorClient fake and
+// adds ApplyOperatorStatus, so it satisfies statusApplyClient and drives
+// applyStatus down the server-side apply branch instead of the legacy
+// read-modify-write fallback.
+type fakeApplyOperatorClient struct {
+	v1helpers.OperatorClient
+	calledFieldManager string
+	appliedStatus      *applyoperatorv1.OperatorStatusApplyConfiguration
+}
+
+func (f *fakeApplyOperatorClient) ApplyOperatorStatus(ctx context.Context, fieldManager string, applyConfiguration *applyoperatorv1.OperatorStatusApplyConfiguration) error {
+	f.calledFieldManager = fieldManager
+	f.appliedStatus = applyConfiguration
+	return nil
+}
+
+func TestGenerationApplyConfiguration(t *testing.T) {
+	tests := []struct {
+		name             string
+		workload         Workload
+		wantGroup        string
+		wantResource     string
+		wantGeneration   int64
+		wantLastGenToken string
+	}{
+		{
+			name: "deployment",
+			workload: NewDeploymentWorkload(&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "ns", Generation: 3},
+			}),
+			wantGroup:    "apps",
+			wantResource: "deployments",
+		},
+		{
+			name: "daemonset",
+			workload: NewDaemonSetWorkload(&appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "ds", Namespace: "ns", Generation: 4},
+			}),
+			wantGroup:    "apps",
+			wantResource: "daemonsets",
+		},
+		{
+			name: "statefulset",
+			workload: NewStatefulSetWorkload(&appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "sts", Namespace: "ns", Generation: 5},
+			}),
+			wantGroup:    "apps",
+			wantResource: "statefulsets",
+		},
+	}
+
+	c := &Controller{}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gen := c.generationApplyConfiguration(tc.workload)
+			if gen.Group == nil || *gen.Group != tc.wantGroup {
+				t.Errorf("expected group %q, got %v", tc.wantGroup, gen.Group)
+			}
+			if gen.Resource == nil || *gen.Resource != tc.wantResource {
+				t.Errorf("expected resource %q, got %v", tc.wantResource, gen.Resource)
+			}
+			if gen.Namespace == nil || *gen.Namespace != tc.workload.GetNamespace() {
+				t.Errorf("expected namespace %q, got %v", tc.workload.GetNamespace(), gen.Namespace)
+			}
+			if gen.Name == nil || *gen.Name != tc.workload.GetName() {
+				t.Errorf("expected name %q, got %v", tc.workload.GetName(), gen.Name)
+			}
+			if gen.LastGeneration == nil || *gen.LastGeneration != tc.workload.GetGeneration() {
+				t.Errorf("expected lastGeneration %d, got %v", tc.workload.GetGeneration(), gen.LastGeneration)
+			}
+		})
+	}
+}
+
+func TestToOperatorCondition(t *testing.T) {
+	applyCondition := applyoperatorv1.OperatorCondition().
+		WithType("FooDegraded").
+		WithStatus(operatorv1.ConditionTrue).
+		WithReason("SyncError").
+		WithMessage("boom")
+
+	got := toOperatorCondition(applyCondition)
+	want := operatorv1.OperatorCondition{
+		Type:    "FooDegraded",
+		Status:  operatorv1.ConditionTrue,
+		Reason:  "SyncError",
+		Message: "boom",
+	}
+	if got != want {
+		t.Errorf("toOperatorCondition() = %+v, want %+v", got, want)
+	}
+}
+
+func TestToOperatorConditionOmitsUnsetFields(t *testing.T) {
+	applyCondition := applyoperatorv1.OperatorCondition().WithType("FooAvailable")
+
+	got := toOperatorCondition(applyCondition)
+	if got.Type != "FooAvailable" {
+		t.Errorf("expected Type FooAvailable, got %q", got.Type)
+	}
+	if got.Status != "" || got.Reason != "" || got.Message != "" {
+		t.Errorf("expected unset fields to stay zero-valued, got %+v", got)
+	}
+}
+
+func TestWithStatusApplyConfigurationAppliesBuilder(t *testing.T) {
+	called := false
+	opt := WithStatusApplyConfiguration(func(status *applyoperatorv1.OperatorStatusApplyConfiguration) *applyoperatorv1.OperatorStatusApplyConfiguration {
+		called = true
+		return status.WithObservedGeneration(7)
+	})
+
+	c := &Controller{}
+	opt(c)
+	if c.statusApplyConfigurationBuilder == nil {
+		t.Fatal("expected statusApplyConfigurationBuilder to be set")
+	}
+
+	status := c.statusApplyConfigurationBuilder(applyoperatorv1.OperatorStatus())
+	if !called {
+		t.Error("expected the registered builder to run")
+	}
+	if status.ObservedGeneration == nil || *status.ObservedGeneration != 7 {
+		t.Errorf("expected the builder's mutation to be reflected, got %v", status.ObservedGeneration)
+	}
+}
+
+func TestApplyStatusUsesServerSideApplyWhenSupported(t *testing.T) {
+	client := &fakeApplyOperatorClient{OperatorClient: v1helpers.NewFakeOperatorClient(&operatorv1.OperatorSpec{}, &operatorv1.OperatorStatus{}, nil)}
+	c := &Controller{fieldManager: "test-field-manager", operatorClient: client}
+
+	conditions := []*applyoperatorv1.OperatorConditionApplyConfiguration{
+		applyoperatorv1.OperatorCondition().WithType("FooAvailable").WithStatus(operatorv1.ConditionTrue),
+	}
+	workload := NewDeploymentWorkload(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "ns", Generation: 2},
+	})
+
+	if err := c.applyStatus(context.Background(), conditions, workload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if client.calledFieldManager != "test-field-manager" {
+		t.Errorf("expected ApplyOperatorStatus to be called with the controller's field manager, got %q", client.calledFieldManager)
+	}
+	if client.appliedStatus == nil {
+		t.Fatal("expected ApplyOperatorStatus to be called")
+	}
+	if len(client.appliedStatus.Conditions) != 1 || client.appliedStatus.Conditions[0].Type == nil || *client.appliedStatus.Conditions[0].Type != "FooAvailable" {
+		t.Errorf("expected the FooAvailable condition to be carried through, got %+v", client.appliedStatus.Conditions)
+	}
+	if len(client.appliedStatus.Generations) != 1 {
+		t.Fatalf("expected 1 generation entry for the given workload, got %d", len(client.appliedStatus.Generations))
+	}
+	gen := client.appliedStatus.Generations[0]
+	if gen.Name == nil || *gen.Name != "d" || gen.LastGeneration == nil || *gen.LastGeneration != 2 {
+		t.Errorf("expected the generation entry to track the workload, got %+v", gen)
+	}
+}
+
+func TestApplyStatusAppliesCustomStatusBuilder(t *testing.T) {
+	client := &fakeApplyOperatorClient{OperatorClient: v1helpers.NewFakeOperatorClient(&operatorv1.OperatorSpec{}, &operatorv1.OperatorStatus{}, nil)}
+	c := &Controller{
+		fieldManager:   "test-field-manager",
+		operatorClient: client,
+		statusApplyConfigurationBuilder: func(status *applyoperatorv1.OperatorStatusApplyConfiguration) *applyoperatorv1.OperatorStatusApplyConfiguration {
+			return status.WithObservedGeneration(42)
+		},
+	}
+
+	if err := c.applyStatus(context.Background(), nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if client.appliedStatus == nil || client.appliedStatus.ObservedGeneration == nil || *client.appliedStatus.ObservedGeneration != 42 {
+		t.Errorf("expected the registered status builder's mutation to reach ApplyOperatorStatus, got %+v", client.appliedStatus)
+	}
+}
+
+func TestApplyStatusFallsBackToLegacyUpdateStatus(t *testing.T) {
+	client := v1helpers.NewFakeOperatorClient(&operatorv1.OperatorSpec{}, &operatorv1.OperatorStatus{}, nil)
+	c := &Controller{operatorClient: client}
+
+	conditions := []*applyoperatorv1.OperatorConditionApplyConfiguration{
+		applyoperatorv1.OperatorCondition().WithType("FooAvailable").WithStatus(operatorv1.ConditionTrue).WithReason("AsExpected"),
+	}
+	if err := c.applyStatus(context.Background(), conditions, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, status, _, _ := client.GetOperatorState()
+	got := findCondition(status, "FooAvailable")
+	if got == nil || got.Status != operatorv1.ConditionTrue || got.Reason != "AsExpected" {
+		t.Errorf("expected the legacy UpdateStatus path to persist the condition, got %+v", got)
+	}
+}
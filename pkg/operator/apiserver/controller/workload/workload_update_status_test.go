@@ -0,0 +1,89 @@
+package workload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/library-go/pkg/operator/v1helpers"
+)
+
+func findCondition(status *operatorv1.OperatorStatus, conditionType string) *operatorv1.OperatorCondition {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == conditionType {
+			return &status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func TestUpdateOperatorStatusResourceNotFound(t *testing.T) {
+	client := v1helpers.NewFakeOperatorClient(&operatorv1.OperatorSpec{}, &operatorv1.OperatorStatus{}, nil)
+	c := &Controller{conditionsPrefix: "Foo", workloadKind: DeploymentKind, operatorClient: client}
+
+	err := c.updateOperatorStatus(context.Background(), nil, true, true, []error{fmt.Errorf("wrap: %w", ErrWorkloadNotFound)})
+	if err == nil {
+		t.Fatal("expected the aggregated sync error to be returned")
+	}
+
+	_, status, _, _ := client.GetOperatorState()
+	available := findCondition(status, "FooDeploymentAvailable")
+	if available == nil || available.Status != operatorv1.ConditionFalse || available.Reason != "ResourceNotFound" {
+		t.Errorf("expected Available=False/ResourceNotFound, got %+v", available)
+	}
+	degraded := findCondition(status, "FooDeploymentDegraded")
+	if degraded == nil || degraded.Status != operatorv1.ConditionTrue || degraded.Reason != "ResourceNotFound" {
+		t.Errorf("expected Degraded=True/ResourceNotFound, got %+v", degraded)
+	}
+}
+
+func TestUpdateOperatorStatusSyncFailedWithNoPriorAvailable(t *testing.T) {
+	client := v1helpers.NewFakeOperatorClient(&operatorv1.OperatorSpec{}, &operatorv1.OperatorStatus{}, nil)
+	c := &Controller{conditionsPrefix: "Foo", workloadKind: DeploymentKind, operatorClient: client}
+
+	err := c.updateOperatorStatus(context.Background(), nil, true, true, []error{errors.New("transient API error")})
+	if err == nil {
+		t.Fatal("expected the aggregated sync error to be returned")
+	}
+
+	_, status, _, _ := client.GetOperatorState()
+	available := findCondition(status, "FooDeploymentAvailable")
+	if available == nil || available.Status != operatorv1.ConditionFalse || available.Reason != "SyncFailed" {
+		t.Errorf("expected Available=False/SyncFailed when there's no prior condition to preserve, got %+v", available)
+	}
+}
+
+func TestUpdateOperatorStatusSyncFailedPreservesLastKnownAvailable(t *testing.T) {
+	initialStatus := &operatorv1.OperatorStatus{
+		Conditions: []operatorv1.OperatorCondition{
+			{
+				Type:    "FooDeploymentAvailable",
+				Status:  operatorv1.ConditionTrue,
+				Reason:  "AsExpected",
+				Message: "all good last time we synced",
+			},
+		},
+	}
+	client := v1helpers.NewFakeOperatorClient(&operatorv1.OperatorSpec{}, initialStatus, nil)
+	c := &Controller{conditionsPrefix: "Foo", workloadKind: DeploymentKind, operatorClient: client}
+
+	err := c.updateOperatorStatus(context.Background(), nil, true, true, []error{errors.New("transient API error")})
+	if err == nil {
+		t.Fatal("expected the aggregated sync error to be returned")
+	}
+
+	_, status, _, _ := client.GetOperatorState()
+	available := findCondition(status, "FooDeploymentAvailable")
+	if available == nil || available.Status != operatorv1.ConditionTrue || available.Reason != "AsExpected" {
+		t.Errorf("expected the last known good Available condition to be preserved, got %+v", available)
+	}
+
+	// Progressing and the kind-Degraded condition should still reflect the
+	// failure, even while Available is preserved.
+	degraded := findCondition(status, "FooDeploymentDegraded")
+	if degraded == nil || degraded.Status != operatorv1.ConditionTrue || degraded.Reason != "SyncFailed" {
+		t.Errorf("expected Degraded=True/SyncFailed, got %+v", degraded)
+	}
+}